@@ -0,0 +1,167 @@
+package tree_sitter_avenger
+
+import (
+	"context"
+	"fmt"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Parser parses Avenger source into syntax trees. It wraps a
+// tree_sitter.Parser pre-configured with the Avenger grammar so callers
+// don't have to wire up tree_sitter.NewLanguage themselves.
+type Parser struct {
+	inner *tree_sitter.Parser
+}
+
+// NewParser returns a Parser ready to parse Avenger source.
+func NewParser() (*Parser, error) {
+	inner := tree_sitter.NewParser()
+	if err := inner.SetLanguage(tree_sitter.NewLanguage(Language())); err != nil {
+		return nil, fmt.Errorf("setting avenger language: %w", err)
+	}
+	return &Parser{inner: inner}, nil
+}
+
+// Close frees the parser's native resources.
+func (p *Parser) Close() {
+	p.inner.Close()
+}
+
+// Parse parses src from scratch and returns the resulting Tree.
+func (p *Parser) Parse(src []byte) (*Tree, error) {
+	return p.ParseCtx(context.Background(), src)
+}
+
+// ParseCtx parses src from scratch, aborting if ctx is done before parsing
+// completes.
+func (p *Parser) ParseCtx(ctx context.Context, src []byte) (*Tree, error) {
+	tree := p.inner.ParseCtx(ctx, src, nil)
+	if tree == nil {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("parsing avenger source: parser returned no tree")
+	}
+	return &Tree{inner: tree, src: src}, nil
+}
+
+// ParseEdit incrementally reparses newSrc, reusing the unaffected parts of
+// oldTree. edit describes the byte range and position that changed between
+// oldTree's source and newSrc.
+func (p *Parser) ParseEdit(oldTree *Tree, edit tree_sitter.InputEdit, newSrc []byte) (*Tree, error) {
+	oldTree.inner.Edit(edit)
+	tree := p.inner.Parse(newSrc, oldTree.inner)
+	if tree == nil {
+		return nil, fmt.Errorf("reparsing avenger source: parser returned no tree")
+	}
+	return &Tree{inner: tree, src: newSrc}, nil
+}
+
+// Tree is a parsed Avenger syntax tree together with the source it was
+// parsed from, so that Node methods can resolve text without callers having
+// to thread the buffer around themselves.
+type Tree struct {
+	inner *tree_sitter.Tree
+	src   []byte
+}
+
+// Close frees the tree's native resources.
+func (t *Tree) Close() {
+	t.inner.Close()
+}
+
+// RootNode returns the tree's root node.
+func (t *Tree) RootNode() Node {
+	return Node{inner: t.inner.RootNode(), src: t.src}
+}
+
+// Node wraps a tree_sitter.Node with the Avenger source it belongs to,
+// adding the lookups Avenger tooling needs on top of the raw binding.
+type Node struct {
+	inner tree_sitter.Node
+	src   []byte
+}
+
+// Raw returns the underlying tree_sitter.Node, for callers that need the
+// full go-tree-sitter API (e.g. query matching).
+func (n Node) Raw() tree_sitter.Node {
+	return n.inner
+}
+
+// Kind returns the grammar rule name for n, e.g. "assignment".
+func (n Node) Kind() string {
+	return n.inner.Kind()
+}
+
+// IsMissing reports whether n was inserted by the parser's error recovery
+// rather than appearing in the source.
+func (n Node) IsMissing() bool {
+	return n.inner.IsMissing()
+}
+
+// HasError reports whether n or any of its descendants is an ERROR node or
+// a missing node produced by error recovery.
+func (n Node) HasError() bool {
+	return n.inner.HasError()
+}
+
+// Range returns the byte range and position span n covers in the source.
+func (n Node) Range() tree_sitter.Range {
+	return n.inner.Range()
+}
+
+// NamedChildren returns n's named children in source order.
+func (n Node) NamedChildren() []Node {
+	count := n.inner.NamedChildCount()
+	children := make([]Node, 0, count)
+	for i := uint(0); i < count; i++ {
+		children = append(children, Node{inner: *n.inner.NamedChild(i), src: n.src})
+	}
+	return children
+}
+
+// FieldChild returns the child of n bound to the named grammar field. The
+// second return value is false if n has no child for that field.
+func (n Node) FieldChild(name string) (Node, bool) {
+	child := n.inner.ChildByFieldName(name)
+	if child == nil {
+		return Node{}, false
+	}
+	return Node{inner: *child, src: n.src}, true
+}
+
+// Utf8Text returns the source text spanned by n.
+func (n Node) Utf8Text() string {
+	return string(n.src[n.inner.StartByte():n.inner.EndByte()])
+}
+
+// ToSexp returns the S-expression representation of n, e.g.
+// "(source_file (assignment left: (identifier) right: (number)))".
+func (n Node) ToSexp() string {
+	return n.inner.ToSexp()
+}
+
+// Walk visits n and its named descendants in pre-order (a node before its
+// children). If visit returns false for a node, that node's children are
+// skipped.
+func (n Node) Walk(visit func(Node) bool) {
+	if !visit(n) {
+		return
+	}
+	for _, child := range n.NamedChildren() {
+		child.Walk(visit)
+	}
+}
+
+// WalkPostorder visits n and its named descendants in post-order (a node
+// after its children). Returning false from visit stops the walk entirely,
+// including any remaining siblings.
+func (n Node) WalkPostorder(visit func(Node) bool) bool {
+	for _, child := range n.NamedChildren() {
+		if !child.WalkPostorder(visit) {
+			return false
+		}
+	}
+	return visit(n)
+}
@@ -0,0 +1,120 @@
+package tree_sitter_avenger_test
+
+import (
+	"testing"
+
+	tree_sitter_avenger "github.com/jonmmease/avenger/bindings/go"
+)
+
+func TestParseSexp(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "scalar binding",
+			src:  "x = 1",
+			want: "(source_file (assignment left: (identifier) right: (number)))",
+		},
+		{
+			name: "signal reference",
+			src:  "width = signal(\"chart_width\")",
+			want: "(source_file (assignment left: (identifier) right: (call function: (identifier) arguments: (arguments (string)))))",
+		},
+		{
+			name: "mark declaration",
+			src:  "mark rect { x: 0, y: 0 }",
+			want: "(source_file (mark_declaration kind: (identifier) body: (object (pair key: (identifier) value: (number)) (pair key: (identifier) value: (number)))))",
+		},
+	}
+
+	parser, err := tree_sitter_avenger.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+	defer parser.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := parser.Parse([]byte(tt.src))
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.src, err)
+			}
+			defer tree.Close()
+
+			if got := tree.RootNode().ToSexp(); got != tt.want {
+				t.Errorf("Parse(%q).ToSexp() = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWalkVisitsAllNamedNodes(t *testing.T) {
+	parser, err := tree_sitter_avenger.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+	defer parser.Close()
+
+	tree, err := parser.Parse([]byte("x = 1"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	defer tree.Close()
+
+	var preorder, postorder []string
+	tree.RootNode().Walk(func(n tree_sitter_avenger.Node) bool {
+		preorder = append(preorder, n.Kind())
+		return true
+	})
+	tree.RootNode().WalkPostorder(func(n tree_sitter_avenger.Node) bool {
+		postorder = append(postorder, n.Kind())
+		return true
+	})
+
+	wantPreorder := []string{"source_file", "assignment", "identifier", "number"}
+	wantPostorder := []string{"identifier", "number", "assignment", "source_file"}
+
+	if !equal(preorder, wantPreorder) {
+		t.Errorf("preorder walk = %v, want %v", preorder, wantPreorder)
+	}
+	if !equal(postorder, wantPostorder) {
+		t.Errorf("postorder walk = %v, want %v", postorder, wantPostorder)
+	}
+}
+
+func TestFieldChild(t *testing.T) {
+	parser, err := tree_sitter_avenger.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+	defer parser.Close()
+
+	tree, err := parser.Parse([]byte("x = 1"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	defer tree.Close()
+
+	assignment := tree.RootNode().NamedChildren()[0]
+	left, ok := assignment.FieldChild("left")
+	if !ok {
+		t.Fatalf("FieldChild(%q) missing", "left")
+	}
+	if got := left.Utf8Text(); got != "x" {
+		t.Errorf("FieldChild(%q).Utf8Text() = %q, want %q", "left", got, "x")
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
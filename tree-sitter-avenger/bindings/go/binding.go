@@ -0,0 +1,14 @@
+package tree_sitter_avenger
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+// #include "../../src/scanner.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter language for this grammar, ready to be
+// wrapped in a tree_sitter.Language via tree_sitter.NewLanguage.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_avenger())
+}
@@ -0,0 +1,142 @@
+// Package detect classifies files and byte buffers as Avenger source. It
+// combines extension matching, a shebang/first-line heuristic, and a cheap
+// tree-sitter parse whose ERROR-node density serves as a confidence score,
+// in the spirit of src-d/enry's language classifiers.
+package detect
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	tree_sitter_avenger "github.com/jonmmease/avenger/bindings/go"
+)
+
+// extensions lists the file extensions Avenger source is conventionally
+// saved with.
+var extensions = []string{".avg", ".avenger"}
+
+// Extensions returns the file extensions Avenger source is conventionally
+// saved with, dot included (e.g. ".avg").
+func Extensions() []string {
+	out := make([]string, len(extensions))
+	copy(out, extensions)
+	return out
+}
+
+// errorDensityThreshold is the maximum fraction of the source's bytes that
+// may fall inside ERROR nodes before content is rejected as not Avenger. It
+// is a share of bytes, not the share of ERROR nodes among all nodes.
+const errorDensityThreshold = 0.5
+
+// Confidence thresholds IsAvenger applies to Confidence's score. Content
+// whose path already carries an Avenger extension only has to clear a
+// syntax sanity check; content with no extension hint has to parse
+// essentially cleanly.
+const (
+	extensionConfidenceThreshold   = 0.5
+	noExtensionConfidenceThreshold = 0.9
+)
+
+// IsAvenger reports whether content looks like Avenger source. An Avenger
+// extension on path lowers the confidence content has to reach; the path
+// alone never makes content count as Avenger. path may be empty when only an
+// in-memory buffer is available.
+func IsAvenger(path string, content []byte) bool {
+	threshold := noExtensionConfidenceThreshold
+	if hasAvengerExtension(path) {
+		threshold = extensionConfidenceThreshold
+	}
+	return Confidence(content) >= threshold
+}
+
+// Confidence scores how likely content is to be Avenger source, from 0 (not
+// Avenger) to 1 (certainly Avenger).
+func Confidence(content []byte) float64 {
+	if len(content) == 0 {
+		return 0
+	}
+	if hasAvengerShebang(content) {
+		return 1
+	}
+	return parseConfidence(content)
+}
+
+// parseConfidence parses content with the Avenger grammar and scores it by
+// the fraction of the source that falls inside ERROR nodes: a clean parse
+// scores 1, a parse that's mostly unrecognizable scores near 0. tree-sitter
+// collapses an unparseable region into a single top-level ERROR node, so
+// this walk must score by byte span rather than by counting nodes — an
+// ERROR node's own (non-ERROR) descendants would otherwise dilute the count
+// and hide exactly the garbage we're trying to detect. Any node the parser
+// had to synthesize via error recovery (IsMissing) is an unconditional
+// reject, since it means the input was truncated or malformed even where
+// byte coverage looks small.
+func parseConfidence(content []byte) float64 {
+	parser, err := tree_sitter_avenger.NewParser()
+	if err != nil {
+		return 0
+	}
+	defer parser.Close()
+
+	tree, err := parser.Parse(content)
+	if err != nil {
+		return 0
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+	if !root.HasError() {
+		return 1
+	}
+
+	var errorBytes uint
+	root.Walk(func(n tree_sitter_avenger.Node) bool {
+		if n.IsMissing() {
+			errorBytes = uint(len(content))
+			return false
+		}
+		if n.Kind() == "ERROR" {
+			r := n.Range()
+			errorBytes += r.EndByte - r.StartByte
+			return false // ERROR's descendants aren't themselves errors.
+		}
+		// Walk only visits named nodes, so check n's anonymous children
+		// (e.g. a missing closing "}") for missing tokens here.
+		raw := n.Raw()
+		for i := uint(0); i < raw.ChildCount(); i++ {
+			if child := raw.Child(i); child != nil && child.IsMissing() {
+				errorBytes = uint(len(content))
+				return false
+			}
+		}
+		return true
+	})
+
+	density := float64(errorBytes) / float64(len(content))
+	if density > errorDensityThreshold {
+		return 0
+	}
+	return 1 - density
+}
+
+func hasAvengerExtension(path string) bool {
+	if path == "" {
+		return false
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range extensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAvengerShebang(content []byte) bool {
+	line := content
+	if i := bytes.IndexByte(content, '\n'); i >= 0 {
+		line = content[:i]
+	}
+	return bytes.HasPrefix(line, []byte("#!")) && bytes.Contains(line, []byte("avenger"))
+}
@@ -0,0 +1,53 @@
+package detect_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/jonmmease/avenger/bindings/go/detect"
+)
+
+func TestIsAvenger(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		file string
+		want bool
+	}{
+		{name: "true positive with extension", path: "chart.avg", file: "testdata/valid.avg", want: true},
+		{name: "true positive without extension", path: "", file: "testdata/valid.avg", want: true},
+		{name: "adversarial JSON with avg extension", path: "chart.avg", file: "testdata/adversarial.json", want: false},
+		{name: "truncated Avenger fragment", path: "chart.avg", file: "testdata/ambiguous.avg", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(tt.file)
+			if err != nil {
+				t.Fatalf("reading fixture: %v", err)
+			}
+			if got := detect.IsAvenger(tt.path, content); got != tt.want {
+				t.Errorf("IsAvenger(%q, ...) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfidenceRejectsEmptyContent(t *testing.T) {
+	if got := detect.Confidence(nil); got != 0 {
+		t.Errorf("Confidence(nil) = %v, want 0", got)
+	}
+}
+
+func TestExtensions(t *testing.T) {
+	got := detect.Extensions()
+	want := []string{".avg", ".avenger"}
+	if len(got) != len(want) {
+		t.Fatalf("Extensions() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Extensions()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
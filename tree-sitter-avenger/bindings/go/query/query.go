@@ -0,0 +1,94 @@
+// Package query wraps tree-sitter's Query/QueryCursor over the Avenger
+// grammar and bundles the project's curated .scm query set so downstream
+// editors and doc tools don't need to vendor or re-implement it.
+package query
+
+import (
+	_ "embed"
+	"fmt"
+	"iter"
+
+	tree_sitter_avenger "github.com/jonmmease/avenger/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+//go:embed queries/highlights.scm
+var highlightsSrc string
+
+//go:embed queries/locals.scm
+var localsSrc string
+
+//go:embed queries/injections.scm
+var injectionsSrc string
+
+//go:embed queries/tags.scm
+var tagsSrc string
+
+// Query is a compiled tree-sitter query over the Avenger grammar.
+type Query struct {
+	inner *tree_sitter.Query
+}
+
+func compile(src string) (*Query, error) {
+	lang := tree_sitter.NewLanguage(tree_sitter_avenger.Language())
+	inner, err := tree_sitter.NewQuery(lang, src)
+	if err != nil {
+		return nil, fmt.Errorf("compiling avenger query: %w", err)
+	}
+	return &Query{inner: inner}, nil
+}
+
+// LoadHighlights compiles the bundled highlights.scm query.
+func LoadHighlights() (*Query, error) { return compile(highlightsSrc) }
+
+// LoadLocals compiles the bundled locals.scm query.
+func LoadLocals() (*Query, error) { return compile(localsSrc) }
+
+// LoadInjections compiles the bundled injections.scm query.
+func LoadInjections() (*Query, error) { return compile(injectionsSrc) }
+
+// LoadTags compiles the bundled tags.scm query.
+func LoadTags() (*Query, error) { return compile(tagsSrc) }
+
+// Close frees the query's native resources.
+func (q *Query) Close() {
+	q.inner.Close()
+}
+
+// QueryMatch is a single capture produced by running a Query over a tree:
+// the capture's name as written in the .scm file, the node it captured, and
+// the node's byte range for convenience.
+type QueryMatch struct {
+	Capture string
+	Node    tree_sitter.Node
+	Range   tree_sitter.Range
+}
+
+// Matches runs q over tree's root node and yields one QueryMatch per
+// capture, in the order tree-sitter reports them.
+func (q *Query) Matches(tree *tree_sitter_avenger.Tree, src []byte) iter.Seq[QueryMatch] {
+	captureNames := q.inner.CaptureNames()
+	return func(yield func(QueryMatch) bool) {
+		cursor := tree_sitter.NewQueryCursor()
+		defer cursor.Close()
+
+		root := tree.RootNode().Raw()
+		matches := cursor.Matches(q.inner, &root, src)
+		for {
+			match := matches.Next()
+			if match == nil {
+				return
+			}
+			for _, capture := range match.Captures {
+				m := QueryMatch{
+					Capture: captureNames[capture.Index],
+					Node:    capture.Node,
+					Range:   capture.Node.Range(),
+				}
+				if !yield(m) {
+					return
+				}
+			}
+		}
+	}
+}
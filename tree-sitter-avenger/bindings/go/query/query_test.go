@@ -0,0 +1,104 @@
+package query_test
+
+import (
+	"testing"
+
+	tree_sitter_avenger "github.com/jonmmease/avenger/bindings/go"
+	"github.com/jonmmease/avenger/bindings/go/query"
+)
+
+func TestHighlightsCapturesExpectedSpans(t *testing.T) {
+	src := []byte(`width = signal("chart_width")`)
+
+	parser, err := tree_sitter_avenger.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+	defer parser.Close()
+
+	tree, err := parser.Parse(src)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	defer tree.Close()
+
+	q, err := query.LoadHighlights()
+	if err != nil {
+		t.Fatalf("LoadHighlights() error = %v", err)
+	}
+	defer q.Close()
+
+	want := map[string]string{
+		"variable.member": "width",
+		"function":        "signal",
+		"string":          `"chart_width"`,
+	}
+	got := map[string]string{}
+	for m := range q.Matches(tree, src) {
+		got[m.Capture] = string(src[m.Range.StartByte:m.Range.EndByte])
+	}
+
+	for capture, text := range want {
+		if got[capture] != text {
+			t.Errorf("capture %q = %q, want %q", capture, got[capture], text)
+		}
+	}
+}
+
+func TestLocalsCapturesDefinitionAndReference(t *testing.T) {
+	src := []byte(`width = 1
+height = width`)
+
+	parser, err := tree_sitter_avenger.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+	defer parser.Close()
+
+	tree, err := parser.Parse(src)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	defer tree.Close()
+
+	q, err := query.LoadLocals()
+	if err != nil {
+		t.Fatalf("LoadLocals() error = %v", err)
+	}
+	defer q.Close()
+
+	var definitions, references int
+	for m := range q.Matches(tree, src) {
+		switch m.Capture {
+		case "local.definition":
+			definitions++
+		case "local.reference":
+			references++
+		}
+	}
+
+	if definitions != 2 {
+		t.Errorf("local.definition count = %d, want 2", definitions)
+	}
+	if references != 1 {
+		t.Errorf("local.reference count = %d, want 1", references)
+	}
+}
+
+func TestAllQueriesCompile(t *testing.T) {
+	loaders := map[string]func() (*query.Query, error){
+		"highlights": query.LoadHighlights,
+		"locals":     query.LoadLocals,
+		"injections": query.LoadInjections,
+		"tags":       query.LoadTags,
+	}
+	for name, load := range loaders {
+		t.Run(name, func(t *testing.T) {
+			q, err := load()
+			if err != nil {
+				t.Fatalf("%s query failed to compile: %v", name, err)
+			}
+			q.Close()
+		})
+	}
+}
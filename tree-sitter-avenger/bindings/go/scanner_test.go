@@ -0,0 +1,74 @@
+package tree_sitter_avenger_test
+
+import (
+	"os"
+	"testing"
+
+	tree_sitter_avenger "github.com/jonmmease/avenger/bindings/go"
+)
+
+// These fixtures only parse correctly if the external scanner's
+// NEWLINE/INDENT/DEDENT tokens are linked in and declared via grammar.js's
+// externals.
+func TestSignificantIndentation(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		wantKinds []string
+	}{
+		{
+			name:      "single-level indented mark body",
+			fixture:   "../../test/fixtures/indented_block.avg",
+			wantKinds: []string{"mark_declaration"},
+		},
+		{
+			name:      "dedent unwinds two nested levels at once",
+			fixture:   "../../test/fixtures/nested_indent.avg",
+			wantKinds: []string{"group", "mark_declaration", "assignment"},
+		},
+	}
+
+	parser, err := tree_sitter_avenger.NewParser()
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+	defer parser.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := os.ReadFile(tt.fixture)
+			if err != nil {
+				t.Fatalf("reading fixture: %v", err)
+			}
+
+			tree, err := parser.Parse(src)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			defer tree.Close()
+
+			if tree.RootNode().HasError() {
+				t.Fatalf("fixture failed to parse cleanly: %s", tree.RootNode().ToSexp())
+			}
+
+			var kinds []string
+			tree.RootNode().Walk(func(n tree_sitter_avenger.Node) bool {
+				kinds = append(kinds, n.Kind())
+				return true
+			})
+
+			for _, want := range tt.wantKinds {
+				found := false
+				for _, kind := range kinds {
+					if kind == want {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("expected a %q node in the parse, got kinds %v", want, kinds)
+				}
+			}
+		})
+	}
+}